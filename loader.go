@@ -0,0 +1,123 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/ghodss/yaml"
+)
+
+func TransformFromFile(path string) (Design, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Design{}, err
+	}
+
+	return TransformFromBytes(data)
+}
+
+func TransformFromBytes(data []byte) (Design, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return Design{}, fmt.Errorf("spec: unable to parse document: %w", err)
+	}
+
+	var root struct {
+		Swagger string `json:"swagger"`
+		OpenAPI string `json:"openapi"`
+	}
+
+	if err := json.Unmarshal(jsonData, &root); err != nil {
+		return Design{}, fmt.Errorf("spec: unable to parse document: %w", err)
+	}
+
+	switch {
+	case root.Swagger != "":
+		return transformSwagger2(jsonData)
+	case strings.HasPrefix(root.OpenAPI, "3.1"):
+		return transformOpenAPI31(jsonData)
+	case root.OpenAPI != "":
+		return transformOpenAPI3(jsonData)
+	default:
+		return Design{}, fmt.Errorf("spec: document is missing both \"swagger\" and \"openapi\" root keys")
+	}
+}
+
+func transformOpenAPI3(data []byte) (Design, error) {
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData(data)
+	if err != nil {
+		return Design{}, err
+	}
+
+	return Transform(swagger), nil
+}
+
+func transformOpenAPI31(data []byte) (Design, error) {
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return Design{}, err
+	}
+
+	normalizeNullableTypes(document)
+
+	normalized, err := json.Marshal(document)
+	if err != nil {
+		return Design{}, err
+	}
+
+	return transformOpenAPI3(normalized)
+}
+
+func transformSwagger2(data []byte) (Design, error) {
+	var doc2 openapi2.Swagger
+	if err := json.Unmarshal(data, &doc2); err != nil {
+		return Design{}, err
+	}
+
+	doc3, err := openapi2conv.ToV3Swagger(&doc2)
+	if err != nil {
+		return Design{}, err
+	}
+
+	return Transform(doc3), nil
+}
+
+// normalizeNullableTypes collapses the OpenAPI 3.1 / JSON Schema 2020-12
+// `type: [T, "null"]` form into the 3.0 `type: T, nullable: true` form
+// traverse already understands.
+func normalizeNullableTypes(node interface{}) {
+	switch value := node.(type) {
+	case map[string]interface{}:
+		if types, ok := value["type"].([]interface{}); ok {
+			var rest []string
+			hasNull := false
+			for _, t := range types {
+				if s, ok := t.(string); ok {
+					if s == "null" {
+						hasNull = true
+					} else {
+						rest = append(rest, s)
+					}
+				}
+			}
+
+			if hasNull && len(rest) == 1 {
+				value["type"] = rest[0]
+				value["nullable"] = true
+			}
+		}
+
+		for _, child := range value {
+			normalizeNullableTypes(child)
+		}
+	case []interface{}:
+		for _, child := range value {
+			normalizeNullableTypes(child)
+		}
+	}
+}
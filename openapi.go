@@ -0,0 +1,281 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ToOpenAPI rebuilds an OpenAPI 3.0 document from a Design, re-nesting the
+// flat RqBody/RsBody Parameter lists on Parent. It reverses Transform on a
+// best-effort basis: no components section is written, so a recursive[...]
+// node becomes a $ref to a component the document does not contain, and
+// security schemes come back as plain header or query parameters rather than
+// securitySchemes. A Design assembled by hand or merged from several imports
+// may not round-trip byte-for-byte.
+func (d Design) ToOpenAPI() (*openapi3.Swagger, error) {
+	swagger := &openapi3.Swagger{
+		OpenAPI: "3.0.0",
+		Info: &openapi3.Info{
+			Title:       d.Info.Title,
+			Version:     d.Info.Version,
+			Description: d.Info.Description,
+		},
+		Paths: make(openapi3.Paths),
+	}
+
+	for _, resource := range d.Resources {
+		pathItem, ok := swagger.Paths[resource.Endpoint]
+		if !ok {
+			pathItem = &openapi3.PathItem{}
+			swagger.Paths[resource.Endpoint] = pathItem
+		}
+
+		pathItem.SetOperation(strings.ToUpper(resource.RequestVerb), resourceToOperation(resource))
+	}
+
+	return swagger, nil
+}
+
+// ToOpenAPIJSON renders the OpenAPI document ToOpenAPI builds as JSON. It does
+// not implement json.Marshaler for Design: Design keeps its own default JSON
+// shape, and callers that want the OpenAPI projection ask for it explicitly.
+func (d Design) ToOpenAPIJSON() ([]byte, error) {
+	swagger, err := d.ToOpenAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(swagger)
+}
+
+// ToOpenAPIYAML returns the OpenAPI document ToOpenAPI builds as a generic
+// value (maps, slices and scalars decoded from its JSON form) that a YAML
+// encoder can marshal. It does not produce YAML text itself.
+func (d Design) ToOpenAPIYAML() (interface{}, error) {
+	swagger, err := d.ToOpenAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(swagger)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func resourceToOperation(resource *Resource) *openapi3.Operation {
+	operation := &openapi3.Operation{
+		Summary:     resource.ResourceDefinition,
+		Description: resource.Description,
+		Responses:   make(openapi3.Responses),
+	}
+
+	content := resource.ResourceContent
+
+	for _, parameter := range content.RqHeader {
+		operation.Parameters = append(operation.Parameters, parameterRef(parameter))
+	}
+	for _, parameter := range content.RqPath {
+		operation.Parameters = append(operation.Parameters, parameterRef(parameter))
+	}
+	for _, parameter := range content.RqQuery {
+		operation.Parameters = append(operation.Parameters, parameterRef(parameter))
+	}
+
+	if len(content.RqBody) > 0 {
+		operation.RequestBody = &openapi3.RequestBodyRef{Value: requestBody(content.RqBody, content.RqBodyExample)}
+	}
+
+	operation.Responses = responses(content)
+
+	return operation
+}
+
+func parameterRef(parameter *Parameter) *openapi3.ParameterRef {
+	return &openapi3.ParameterRef{
+		Value: &openapi3.Parameter{
+			Name:        parameter.Name,
+			In:          parameter.Location,
+			Description: parameter.Description,
+			Required:    parameter.Required,
+			Schema:      &openapi3.SchemaRef{Value: &openapi3.Schema{Type: parameter.DataType}},
+		},
+	}
+}
+
+func requestBody(bodies map[string][]*Parameter, examples map[string]map[string]string) *openapi3.RequestBody {
+	body := &openapi3.RequestBody{Content: make(openapi3.Content)}
+
+	for contentType, parameters := range bodies {
+		mediaType := &openapi3.MediaType{Schema: schemaFromParameters(parameters)}
+		attachExamples(mediaType, examples[contentType])
+		body.Content[contentType] = mediaType
+	}
+
+	return body
+}
+
+func responses(content ResourceContent) openapi3.Responses {
+	result := make(openapi3.Responses)
+
+	codes := make(map[string]bool)
+	for code := range content.RsBody {
+		codes[code] = true
+	}
+	for code := range content.RsHeader {
+		codes[code] = true
+	}
+	for code := range content.RsBodyExample {
+		codes[code] = true
+	}
+
+	for code := range codes {
+		response := &openapi3.Response{Content: make(openapi3.Content)}
+
+		for contentType, parameters := range content.RsBody[code] {
+			mediaType := &openapi3.MediaType{Schema: schemaFromParameters(parameters)}
+			attachExamples(mediaType, content.RsBodyExample[code])
+			response.Content[contentType] = mediaType
+		}
+
+		if headers := content.RsHeader[code]; len(headers) > 0 {
+			response.Headers = make(map[string]*openapi3.HeaderRef)
+			for _, parameter := range headers {
+				response.Headers[parameter.Name] = &openapi3.HeaderRef{
+					Value: &openapi3.Header{
+						Description: parameter.Description,
+						Required:    parameter.Required,
+						Schema:      &openapi3.SchemaRef{Value: &openapi3.Schema{Type: parameter.DataType}},
+					},
+				}
+			}
+		}
+
+		result[code] = &openapi3.ResponseRef{Value: response}
+	}
+
+	return result
+}
+
+func attachExamples(mediaType *openapi3.MediaType, examples map[string]string) {
+	if len(examples) == 0 {
+		return
+	}
+
+	mediaType.Examples = make(map[string]*openapi3.ExampleRef)
+	for key, raw := range examples {
+		var value interface{}
+		if err := json.Unmarshal([]byte(raw), &value); err != nil {
+			continue
+		}
+
+		mediaType.Examples[key] = &openapi3.ExampleRef{Value: &openapi3.Example{Value: value}}
+	}
+}
+
+// schemaFromParameters re-nests parameters (the pre-order, depth-first
+// flattening traverse produces for one body) back into a Schema tree. It
+// walks the slice by position rather than by matching Parent against Name,
+// since Name alone is ambiguous whenever two unrelated branches declare a
+// property with the same key (e.g. two different "address" objects).
+func schemaFromParameters(parameters []*Parameter) *openapi3.SchemaRef {
+	if len(parameters) == 0 {
+		return nil
+	}
+
+	schema, _ := parameterToSchemaRef(0, parameters)
+	return schema
+}
+
+// recursiveRefName extracts "Foo" from the "recursive[Foo]" sentinel
+// traverseSchema emits when it breaks a schema cycle.
+func recursiveRefName(dataType string) (string, bool) {
+	if !strings.HasPrefix(dataType, "recursive[") {
+		return "", false
+	}
+
+	return strings.TrimSuffix(strings.TrimPrefix(dataType, "recursive["), "]"), true
+}
+
+// parameterToSchemaRef builds the SchemaRef rooted at all[index] and returns
+// it alongside the index of the first element that is no longer part of its
+// subtree, so callers can resume consuming siblings from the right place
+// instead of re-scanning the whole slice by name.
+func parameterToSchemaRef(index int, all []*Parameter) (*openapi3.SchemaRef, int) {
+	parameter := all[index]
+	next := index + 1
+
+	if name, ok := recursiveRefName(parameter.DataType); ok {
+		return &openapi3.SchemaRef{Ref: fmt.Sprintf("#/components/schemas/%s", name)}, next
+	}
+
+	schema := &openapi3.Schema{
+		Title:       parameter.Name,
+		Description: parameter.Description,
+		Nullable:    !parameter.Required,
+		Format:      parameter.Format,
+		Default:     parameter.Default,
+		Pattern:     parameter.Pattern,
+		Deprecated:  parameter.Deprecated,
+		Min:         parameter.Minimum,
+		Max:         parameter.Maximum,
+	}
+
+	for _, value := range parameter.Enum {
+		schema.Enum = append(schema.Enum, value)
+	}
+
+	switch {
+	case parameter.DataType == "oneOf" || parameter.DataType == "anyOf":
+		prefix := fmt.Sprintf("%s[%s:", parameter.Name, parameter.DataType)
+		var variants []*openapi3.SchemaRef
+
+		for next < len(all) && strings.HasPrefix(all[next].Parent, prefix) {
+			var variant *openapi3.SchemaRef
+			variant, next = parameterToSchemaRef(next, all)
+			variants = append(variants, variant)
+		}
+
+		if parameter.DataType == "oneOf" {
+			schema.OneOf = variants
+		} else {
+			schema.AnyOf = variants
+		}
+	case parameter.DataType == "object":
+		schema.Type = "object"
+		schema.Properties = make(map[string]*openapi3.SchemaRef)
+
+		for next < len(all) && all[next].Parent == parameter.Name {
+			child := all[next]
+			var childSchema *openapi3.SchemaRef
+			childSchema, next = parameterToSchemaRef(next, all)
+
+			schema.Properties[child.Name] = childSchema
+			if child.Required {
+				schema.Required = append(schema.Required, child.Name)
+			}
+		}
+	case strings.HasPrefix(parameter.DataType, "array["):
+		schema.Type = "array"
+
+		if next < len(all) && all[next].Parent == parameter.Name {
+			var itemSchema *openapi3.SchemaRef
+			itemSchema, next = parameterToSchemaRef(next, all)
+			schema.Items = itemSchema
+		}
+	default:
+		schema.Type = parameter.DataType
+	}
+
+	return &openapi3.SchemaRef{Value: schema}, next
+}
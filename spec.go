@@ -15,23 +15,30 @@ type Info struct {
 }
 
 type Parameter struct {
-	Parent      string `json:"parent"`
-	Name        string `json:"name"`
-	Location    string `json:"location"`
-	DataType    string `json:"data_type"`
-	Required    bool   `json:"required"`
-	Description string `json:"description"`
+	Parent      string        `json:"parent"`
+	Name        string        `json:"name"`
+	Location    string        `json:"location"`
+	DataType    string        `json:"data_type"`
+	Required    bool          `json:"required"`
+	Description string        `json:"description"`
+	Format      string        `json:"format,omitempty"`
+	Enum        []interface{} `json:"enum,omitempty"`
+	Default     interface{}   `json:"default,omitempty"`
+	Minimum     *float64      `json:"minimum,omitempty"`
+	Maximum     *float64      `json:"maximum,omitempty"`
+	Pattern     string        `json:"pattern,omitempty"`
+	Deprecated  bool          `json:"deprecated,omitempty"`
 }
 
 type ResourceContent struct {
-	RqHeader      []*Parameter                 `json:"request_header"`
-	RqPath        []*Parameter                 `json:"request_path"`
-	RqQuery       []*Parameter                 `json:"request_query"`
-	RqBody        map[string][]*Parameter      `json:"request_body"`
-	RqBodyExample map[string]map[string]string `json:"request_body_example"`
-	RsHeader      []*Parameter                 `json:"response_header"`
-	RsBody        map[string][]*Parameter      `json:"response_body"`
-	RsBodyExample map[string]map[string]string `json:"response_body_example"`
+	RqHeader      []*Parameter                        `json:"request_header"`
+	RqPath        []*Parameter                        `json:"request_path"`
+	RqQuery       []*Parameter                        `json:"request_query"`
+	RqBody        map[string][]*Parameter             `json:"request_body"`
+	RqBodyExample map[string]map[string]string        `json:"request_body_example"`
+	RsHeader      map[string][]*Parameter             `json:"response_header"`
+	RsBody        map[string]map[string][]*Parameter  `json:"response_body"`
+	RsBodyExample map[string]map[string]string        `json:"response_body_example"`
 }
 
 type Resource struct {
@@ -48,10 +55,22 @@ type Design struct {
 	Resources []*Resource `json:"resources"`
 }
 
-func getAuthentication(swagger *openapi3.Swagger) []*Parameter {
+func getAuthentication(swagger *openapi3.Swagger, endpoint string, method string, issues *[]error) []*Parameter {
 	var parameters []*Parameter
 
-	for _, value := range swagger.Components.SecuritySchemes {
+	var names []string
+	for name := range swagger.Components.SecuritySchemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := swagger.Components.SecuritySchemes[name]
+		if value.Value == nil {
+			*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: "request_header", Message: fmt.Sprintf("%s security scheme reference could not be resolved", name)})
+			continue
+		}
+
 		parameter := new(Parameter)
 
 		parameter.Name = value.Value.Name
@@ -66,10 +85,24 @@ func getAuthentication(swagger *openapi3.Swagger) []*Parameter {
 	return parameters
 }
 
-func getPathParameter(swagger *openapi3.Swagger, endpoint string) []*Parameter {
+func getPathParameter(swagger *openapi3.Swagger, endpoint string, method string, issues *[]error) []*Parameter {
 	var parameters []*Parameter
+	pathItem := swagger.Paths.Find(endpoint)
+	if pathItem == nil {
+		return nil
+	}
+
+	for _, value := range pathItem.Parameters {
+		if value.Value == nil {
+			*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: "request_path", Message: "path parameter reference could not be resolved"})
+			continue
+		}
+
+		if value.Value.Schema == nil || value.Value.Schema.Value == nil {
+			*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: "request_path", Message: fmt.Sprintf("%s path parameter has no schema", value.Value.Name)})
+			continue
+		}
 
-	for _, value := range swagger.Paths.Find(endpoint).Parameters {
 		parameter := new(Parameter)
 
 		parameter.Name = value.Value.Name
@@ -84,47 +117,51 @@ func getPathParameter(swagger *openapi3.Swagger, endpoint string) []*Parameter {
 	return parameters
 }
 
-func getParameter(swagger *openapi3.Swagger, endpoint string, method string, location string) []*Parameter {
+func getParameter(swagger *openapi3.Swagger, endpoint string, method string, location string, issues *[]error) []*Parameter {
 	var parameters []*Parameter
-	var param openapi3.Parameters
-	pathItem := swagger.Paths.Find(endpoint)
 
-	switch strings.ToLower(method) {
-	case "get":
-		param = pathItem.Get.Parameters
-	case "post":
-		param = pathItem.Post.Parameters
-	case "put":
-		param = pathItem.Put.Parameters
-	case "patch":
-		param = pathItem.Patch.Parameters
-	case "delete":
-		param = pathItem.Delete.Parameters
-	default:
+	operation := operationFor(swagger.Paths.Find(endpoint), method)
+	if operation == nil || operation.Parameters == nil {
 		return nil
 	}
 
-	if param == nil {
-		return nil
-	}
-
-	for _, value := range param {
-		if strings.Compare(strings.ToLower(value.Value.In), strings.ToLower(location)) == 0 {
-			parameter := new(Parameter)
+	for _, value := range operation.Parameters {
+		if value.Value == nil {
+			*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: fmt.Sprintf("request_%s", location), Message: "parameter reference could not be resolved"})
+			continue
+		}
 
-			parameter.Name = value.Value.Name
-			parameter.Location = strings.ToLower(value.Value.In)
-			parameter.DataType = strings.ToLower(value.Value.Schema.Value.Type)
-			parameter.Required = value.Value.Required
-			parameter.Description = value.Value.Description
+		if strings.Compare(strings.ToLower(value.Value.In), strings.ToLower(location)) != 0 {
+			continue
+		}
 
-			parameters = append(parameters, parameter)
+		if value.Value.Schema == nil || value.Value.Schema.Value == nil {
+			*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: fmt.Sprintf("request_%s", location), Message: fmt.Sprintf("%s parameter has no schema", value.Value.Name)})
+			continue
 		}
+
+		parameter := new(Parameter)
+
+		parameter.Name = value.Value.Name
+		parameter.Location = strings.ToLower(value.Value.In)
+		parameter.DataType = strings.ToLower(value.Value.Schema.Value.Type)
+		parameter.Required = value.Value.Required
+		parameter.Description = value.Value.Description
+
+		parameters = append(parameters, parameter)
 	}
 
 	return parameters
 }
 
+func propertyGroup(dataType string) int {
+	if dataType == "object" || dataType == "array" {
+		return 1
+	}
+
+	return 0
+}
+
 func contains(array []string, key string) bool {
 	for _, value := range array {
 		if strings.Compare(value, key) == 0 {
@@ -135,6 +172,19 @@ func contains(array []string, key string) bool {
 	return false
 }
 
+func setMetadata(parameter *Parameter, schema *openapi3.Schema) {
+	parameter.Format = schema.Format
+	parameter.Default = schema.Default
+	parameter.Pattern = schema.Pattern
+	parameter.Deprecated = schema.Deprecated
+	parameter.Minimum = schema.Min
+	parameter.Maximum = schema.Max
+
+	for _, value := range schema.Enum {
+		parameter.Enum = append(parameter.Enum, value)
+	}
+}
+
 func setNode(schema *openapi3.SchemaRef) *Parameter {
 	parameter := new(Parameter)
 	parameter.Name = schema.Value.Title
@@ -142,22 +192,116 @@ func setNode(schema *openapi3.SchemaRef) *Parameter {
 	parameter.Location = "body"
 	parameter.Required = !schema.Value.Nullable
 	parameter.Description = schema.Value.Description
+	setMetadata(parameter, schema.Value)
 
 	return parameter
 }
 
-func setPlain(schema *openapi3.SchemaRef) *Parameter {
-	parameter := new(Parameter)
-	parameter.Name = schema.Value.Title
-	parameter.DataType = strings.ToLower(schema.Value.Type)
-	parameter.Location = "body"
-	parameter.Required = !schema.Value.Nullable
-	parameter.Description = schema.Value.Description
+// mergeAllOf flattens allOf members into a single synthetic object schema
+// so the rest of traverse can keep walking Properties/Required as usual.
+func mergeAllOf(schema *openapi3.Schema) *openapi3.Schema {
+	if len(schema.AllOf) == 0 {
+		return schema
+	}
 
-	return parameter
+	merged := *schema
+	merged.Properties = make(map[string]*openapi3.SchemaRef)
+	for key, property := range schema.Properties {
+		merged.Properties[key] = property
+	}
+
+	for _, member := range schema.AllOf {
+		if member.Value == nil {
+			continue
+		}
+
+		sub := mergeAllOf(member.Value)
+		merged.Required = append(merged.Required, sub.Required...)
+		for key, property := range sub.Properties {
+			merged.Properties[key] = property
+		}
+		if merged.Type == "" {
+			merged.Type = sub.Type
+		}
+	}
+
+	if merged.Type == "" {
+		merged.Type = "object"
+	}
+
+	return &merged
 }
 
-func traverse(schema *openapi3.SchemaRef, parent string) (parameters []*Parameter) {
+// discriminatorKey returns the discriminator value that selects variant,
+// looked up via Discriminator.Mapping's ref, falling back to the variant's
+// own title and finally its positional index when the schema carries no
+// discriminator (or the variant isn't listed in its mapping).
+func discriminatorKey(schema *openapi3.Schema, variant *openapi3.SchemaRef, index int) string {
+	if schema.Discriminator != nil {
+		for value, ref := range schema.Discriminator.Mapping {
+			if ref == variant.Ref {
+				return value
+			}
+		}
+	}
+
+	if variant.Value != nil && variant.Value.Title != "" {
+		return variant.Value.Title
+	}
+
+	return fmt.Sprintf("%d", index)
+}
+
+func traverseComposition(schema *openapi3.SchemaRef, parent string, marker string, variants openapi3.SchemaRefs, visited map[*openapi3.Schema]bool) (parameters []*Parameter) {
+	node := setNode(schema)
+	node.Parent = parent
+	node.DataType = marker
+	parameters = append(parameters, node)
+
+	for index, variant := range variants {
+		key := discriminatorKey(schema.Value, variant, index)
+		branchParent := fmt.Sprintf("%s[%s:%s]", node.Name, marker, key)
+		parameters = append(parameters, traverseSchema(variant, branchParent, visited)...)
+	}
+
+	return parameters
+}
+
+func traverse(schema *openapi3.SchemaRef, parent string) []*Parameter {
+	return traverseSchema(schema, parent, make(map[*openapi3.Schema]bool))
+}
+
+// traverseSchema resolves $ref (schema.Value is already populated by the
+// loader's resolver), merges allOf, branches on oneOf/anyOf, and guards
+// against self-referential schemas via visited.
+func traverseSchema(schema *openapi3.SchemaRef, parent string, visited map[*openapi3.Schema]bool) (parameters []*Parameter) {
+	if schema == nil || schema.Value == nil {
+		return nil
+	}
+
+	if visited[schema.Value] {
+		parameter := new(Parameter)
+		parameter.Parent = parent
+		parameter.Name = schema.Value.Title
+		parameter.DataType = fmt.Sprintf("recursive[%s]", schema.Value.Title)
+		return append(parameters, parameter)
+	}
+
+	visited[schema.Value] = true
+	defer delete(visited, schema.Value)
+
+	if len(schema.Value.OneOf) > 0 {
+		return traverseComposition(schema, parent, "oneOf", schema.Value.OneOf, visited)
+	}
+
+	if len(schema.Value.AnyOf) > 0 {
+		return traverseComposition(schema, parent, "anyOf", schema.Value.AnyOf, visited)
+	}
+
+	if len(schema.Value.AllOf) > 0 {
+		schema = &openapi3.SchemaRef{Value: mergeAllOf(schema.Value)}
+	}
+
 	switch strings.ToLower(schema.Value.Type) {
 	case "object":
 		parameter := setNode(schema)
@@ -165,46 +309,38 @@ func traverse(schema *openapi3.SchemaRef, parent string) (parameters []*Paramete
 		parameters = append(parameters, parameter)
 		var properties []*openapi3.SchemaRef
 		for key, property := range schema.Value.Properties {
+			if property.Value == nil {
+				continue
+			}
 			property.Value.Title = key
 			property.Value.Nullable = !contains(schema.Value.Required, key)
 			properties = append(properties, property)
 		}
 
 		sort.Slice(properties, func(i, j int) bool {
-			if properties[i].Value.Type == "object" || properties[i].Value.Type == "array" {
-				return false
+			iGroup := propertyGroup(properties[i].Value.Type)
+			jGroup := propertyGroup(properties[j].Value.Type)
+			if iGroup != jGroup {
+				return iGroup < jGroup
 			}
-			return true
+			return properties[i].Value.Title < properties[j].Value.Title
 		})
 
 		for _, property := range properties {
-			parameters = append(parameters, traverse(property, parameter.Name)...)
+			parameters = append(parameters, traverseSchema(property, parameter.Name, visited)...)
 		}
 	case "array":
 		parameter := setNode(schema)
 		parameter.Parent = parent
-		parameter.DataType = fmt.Sprintf("array[%s]", schema.Value.Items.Value.Type)
 		parameters = append(parameters, parameter)
 
-		if strings.Compare(schema.Value.Items.Value.Type, "object") == 0 {
-			var properties []*openapi3.SchemaRef
-			for key, property := range schema.Value.Items.Value.Properties {
-				property.Value.Title = key
-				property.Value.Nullable = !contains(schema.Value.Items.Value.Required, key)
-				properties = append(properties, property)
-			}
-
-			sort.Slice(properties, func(i, j int) bool {
-				if properties[i].Value.Type == "object" || properties[i].Value.Type == "array" {
-					return false
-				}
-				return true
-			})
-
-			for _, property := range properties {
-				parameters = append(parameters, traverse(property, parameter.Name)...)
-			}
+		items := schema.Value.Items
+		if items == nil || items.Value == nil {
+			break
 		}
+		parameter.DataType = fmt.Sprintf("array[%s]", strings.ToLower(items.Value.Type))
+
+		parameters = append(parameters, traverseSchema(items, parameter.Name, visited)...)
 	case "string":
 		fallthrough
 	case "number":
@@ -212,7 +348,7 @@ func traverse(schema *openapi3.SchemaRef, parent string) (parameters []*Paramete
 	case "integer":
 		fallthrough
 	case "boolean":
-		parameter := setPlain(schema)
+		parameter := setNode(schema)
 		parameter.Parent = parent
 		parameters = append(parameters, parameter)
 	}
@@ -220,57 +356,61 @@ func traverse(schema *openapi3.SchemaRef, parent string) (parameters []*Paramete
 	return parameters
 }
 
-func getRqBody(swagger *openapi3.Swagger, endpoint string, method string) map[string][]*Parameter {
-	var body *openapi3.RequestBodyRef
-	pathItem := swagger.Paths.Find(endpoint)
+func operationFor(pathItem *openapi3.PathItem, method string) *openapi3.Operation {
+	if pathItem == nil {
+		return nil
+	}
 
 	switch strings.ToLower(method) {
 	case "get":
-		body = pathItem.Get.RequestBody
+		return pathItem.Get
 	case "post":
-		body = pathItem.Post.RequestBody
+		return pathItem.Post
 	case "put":
-		body = pathItem.Put.RequestBody
+		return pathItem.Put
 	case "patch":
-		body = pathItem.Patch.RequestBody
+		return pathItem.Patch
 	case "delete":
-		body = pathItem.Delete.RequestBody
+		return pathItem.Delete
 	default:
 		return nil
 	}
+}
+
+func getRqBody(swagger *openapi3.Swagger, endpoint string, method string, issues *[]error) map[string][]*Parameter {
+	operation := operationFor(swagger.Paths.Find(endpoint), method)
+	if operation == nil || operation.RequestBody == nil {
+		return nil
+	}
 
-	if body == nil {
+	body := operation.RequestBody
+	if body.Value == nil {
+		*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: "request_body", Message: "request body reference could not be resolved"})
 		return nil
 	}
 
 	content := make(map[string][]*Parameter)
 	for key, value := range body.Value.Content {
+		if value.Schema == nil || value.Schema.Value == nil {
+			*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: "request_body", Message: fmt.Sprintf("%s content has no schema", key)})
+			continue
+		}
+
 		content[key] = traverse(value.Schema, "root")
 	}
 
 	return content
 }
 
-func getRqBodyExample(swagger *openapi3.Swagger, endpoint string, method string) map[string]map[string]string {
-	var body *openapi3.RequestBodyRef
-	pathItem := swagger.Paths.Find(endpoint)
-
-	switch strings.ToLower(method) {
-	case "get":
-		body = pathItem.Get.RequestBody
-	case "post":
-		body = pathItem.Post.RequestBody
-	case "put":
-		body = pathItem.Put.RequestBody
-	case "patch":
-		body = pathItem.Patch.RequestBody
-	case "delete":
-		body = pathItem.Delete.RequestBody
-	default:
+func getRqBodyExample(swagger *openapi3.Swagger, endpoint string, method string, issues *[]error) map[string]map[string]string {
+	operation := operationFor(swagger.Paths.Find(endpoint), method)
+	if operation == nil || operation.RequestBody == nil {
 		return nil
 	}
 
-	if body == nil {
+	body := operation.RequestBody
+	if body.Value == nil {
+		*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: "request_body_example", Message: "request body reference could not be resolved"})
 		return nil
 	}
 
@@ -278,6 +418,10 @@ func getRqBodyExample(swagger *openapi3.Swagger, endpoint string, method string)
 	for contentType, value := range body.Value.Content {
 		exampleItem := make(map[string]string)
 		for key, value := range value.Examples {
+			if value.Value == nil {
+				continue
+			}
+
 			marshalled, _ := json.Marshal(value.Value.Value)
 			exampleItem[key] = string(marshalled)
 			example[contentType] = exampleItem
@@ -287,103 +431,95 @@ func getRqBodyExample(swagger *openapi3.Swagger, endpoint string, method string)
 	return example
 }
 
-func getRsHeader(swagger *openapi3.Swagger, endpoint string, method string) []*Parameter {
-	var parameters []*Parameter
-	var headers map[string]*openapi3.HeaderRef
-	pathItem := swagger.Paths.Find(endpoint)
-
-	switch strings.ToLower(method) {
-	case "get":
-		headers = pathItem.Get.Responses["200"].Value.Headers
-	case "post":
-		headers = pathItem.Post.Responses["200"].Value.Headers
-	case "put":
-		headers = pathItem.Put.Responses["200"].Value.Headers
-	case "patch":
-		headers = pathItem.Patch.Responses["200"].Value.Headers
-	case "delete":
-		headers = pathItem.Delete.Responses["200"].Value.Headers
-	default:
+func getRsHeader(swagger *openapi3.Swagger, endpoint string, method string, issues *[]error) map[string][]*Parameter {
+	operation := operationFor(swagger.Paths.Find(endpoint), method)
+	if operation == nil || operation.Responses == nil {
 		return nil
 	}
 
-	if headers == nil {
-		return nil
-	}
+	header := make(map[string][]*Parameter)
+	for httpCode, response := range operation.Responses {
+		if response.Value == nil {
+			*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: "response_header", Message: fmt.Sprintf("status %s response reference could not be resolved", httpCode)})
+			continue
+		}
 
-	for name, header := range headers {
-		parameter := new(Parameter)
-		parameter.Name = name
-		parameter.Location = "header"
-		parameter.DataType = header.Value.Schema.Value.Type
-		parameter.Required = header.Value.Required
-		parameter.Description = header.Value.Description
+		var parameters []*Parameter
+		for name, value := range response.Value.Headers {
+			if value.Value == nil || value.Value.Schema == nil || value.Value.Schema.Value == nil {
+				*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: "response_header", Message: fmt.Sprintf("%s header (status %s) has no schema", name, httpCode)})
+				continue
+			}
 
-		parameters = append(parameters, parameter)
+			parameter := new(Parameter)
+			parameter.Name = name
+			parameter.Location = "header"
+			parameter.DataType = value.Value.Schema.Value.Type
+			parameter.Required = value.Value.Required
+			parameter.Description = value.Value.Description
+
+			parameters = append(parameters, parameter)
+		}
+
+		if parameters != nil {
+			header[httpCode] = parameters
+		}
 	}
 
-	return parameters
+	return header
 }
 
-func getRsBody(swagger *openapi3.Swagger, endpoint string, method string) map[string][]*Parameter {
-	var body *openapi3.ResponseRef
-	pathItem := swagger.Paths.Find(endpoint)
-
-	switch strings.ToLower(method) {
-	case "get":
-		body = pathItem.Get.Responses["200"]
-	case "post":
-		body = pathItem.Post.Responses["200"]
-	case "put":
-		body = pathItem.Put.Responses["200"]
-	case "patch":
-		body = pathItem.Patch.Responses["200"]
-	case "delete":
-		body = pathItem.Delete.Responses["200"]
-	default:
+func getRsBody(swagger *openapi3.Swagger, endpoint string, method string, issues *[]error) map[string]map[string][]*Parameter {
+	operation := operationFor(swagger.Paths.Find(endpoint), method)
+	if operation == nil || operation.Responses == nil {
 		return nil
 	}
 
-	if body == nil {
-		return nil
-	}
+	content := make(map[string]map[string][]*Parameter)
+	for httpCode, response := range operation.Responses {
+		if response.Value == nil {
+			*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: "response_body", Message: fmt.Sprintf("status %s response reference could not be resolved", httpCode)})
+			continue
+		}
 
-	content := make(map[string][]*Parameter)
-	for key, value := range body.Value.Content {
-		content[key] = traverse(value.Schema, "root")
+		contentItem := make(map[string][]*Parameter)
+		for contentType, value := range response.Value.Content {
+			if value.Schema == nil || value.Schema.Value == nil {
+				*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: "response_body", Message: fmt.Sprintf("status %s %s content has no schema", httpCode, contentType)})
+				continue
+			}
+
+			contentItem[contentType] = traverse(value.Schema, "root")
+		}
+
+		if len(contentItem) > 0 {
+			content[httpCode] = contentItem
+		}
 	}
 
 	return content
 }
 
-func getRsBodyExample(swagger *openapi3.Swagger, endpoint string, method string) map[string]map[string]string {
-	var body openapi3.Responses
-	pathItem := swagger.Paths.Find(endpoint)
-
-	switch strings.ToLower(method) {
-	case "get":
-		body = pathItem.Get.Responses
-	case "post":
-		body = pathItem.Post.Responses
-	case "put":
-		body = pathItem.Put.Responses
-	case "patch":
-		body = pathItem.Patch.Responses
-	case "delete":
-		body = pathItem.Delete.Responses
-	default:
-		return nil
-	}
-
-	if body == nil {
+func getRsBodyExample(swagger *openapi3.Swagger, endpoint string, method string, issues *[]error) map[string]map[string]string {
+	operation := operationFor(swagger.Paths.Find(endpoint), method)
+	if operation == nil || operation.Responses == nil {
 		return nil
 	}
 
 	example := make(map[string]map[string]string)
-	for httpCode, value := range body {
+	for httpCode, response := range operation.Responses {
+		if response.Value == nil {
+			*issues = append(*issues, ImportIssue{Endpoint: endpoint, Method: method, Field: "response_body_example", Message: fmt.Sprintf("status %s response reference could not be resolved", httpCode)})
+			continue
+		}
+
 		exampleItem := make(map[string]string)
-		for _, value := range value.Value.Content {
+		for _, value := range response.Value.Content {
 			for key, value := range value.Examples {
+				if value.Value == nil {
+					continue
+				}
+
 				marshalled, _ := json.Marshal(value.Value.Value)
 				exampleItem[key] = string(marshalled)
 				example[httpCode] = exampleItem
@@ -394,11 +530,41 @@ func getRsBodyExample(swagger *openapi3.Swagger, endpoint string, method string)
 	return example
 }
 
+var methodOrder = []string{"get", "post", "put", "patch", "delete"}
+
+func methodRank(method string) int {
+	for index, candidate := range methodOrder {
+		if candidate == method {
+			return index
+		}
+	}
+
+	return len(methodOrder)
+}
+
 func getResource(swagger *openapi3.Swagger) []*Resource {
 	var resources []*Resource
 
-	for path, value := range swagger.Paths {
-		for method, value := range value.Operations() {
+	var paths []string
+	for path := range swagger.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		operations := swagger.Paths[path].Operations()
+
+		var methods []string
+		for method := range operations {
+			methods = append(methods, method)
+		}
+
+		sort.Slice(methods, func(i, j int) bool {
+			return methodRank(strings.ToLower(methods[i])) < methodRank(strings.ToLower(methods[j]))
+		})
+
+		for _, method := range methods {
+			value := operations[method]
 			resource := new(Resource)
 
 			resource.ResourceDefinition = value.Summary
@@ -414,43 +580,66 @@ func getResource(swagger *openapi3.Swagger) []*Resource {
 	return resources
 }
 
-func getResourceContent(swagger *openapi3.Swagger, resources []*Resource) []*Resource {
+func getResourceContent(swagger *openapi3.Swagger, resources []*Resource) ([]*Resource, []error) {
+	var issues []error
+
 	for _, resource := range resources {
-		rqAuth := getAuthentication(swagger)
-		for _, parameter := range rqAuth {
-			if strings.Compare(parameter.Location, "header") == 0 {
-				resource.ResourceContent.RqHeader = append(resource.ResourceContent.RqHeader, parameter)
-			} else if strings.Compare(parameter.Location, "query") == 0 {
-				resource.ResourceContent.RqQuery = append(resource.ResourceContent.RqQuery, parameter)
-			}
+		issues = append(issues, fillResourceContent(swagger, resource)...)
+	}
+
+	return resources, issues
+}
+
+// fillResourceContent populates one resource's content. Each getter guards
+// its own nil derefs and reports them as ImportIssues; recover here is only
+// a last-resort backstop for a bug the getters didn't anticipate, so one
+// resource can't take the whole import down.
+func fillResourceContent(swagger *openapi3.Swagger, resource *Resource) (issues []error) {
+	defer func() {
+		if r := recover(); r != nil {
+			issues = append(issues, ImportIssue{
+				Endpoint: resource.Endpoint,
+				Method:   resource.RequestVerb,
+				Field:    "resource_content",
+				Message:  fmt.Sprintf("skipped operation: %v", r),
+			})
+		}
+	}()
+
+	rqAuth := getAuthentication(swagger, resource.Endpoint, resource.RequestVerb, &issues)
+	for _, parameter := range rqAuth {
+		if strings.Compare(parameter.Location, "header") == 0 {
+			resource.ResourceContent.RqHeader = append(resource.ResourceContent.RqHeader, parameter)
+		} else if strings.Compare(parameter.Location, "query") == 0 {
+			resource.ResourceContent.RqQuery = append(resource.ResourceContent.RqQuery, parameter)
 		}
+	}
 
-		rqPath := getPathParameter(swagger, resource.Endpoint)
-		resource.ResourceContent.RqPath = append(resource.ResourceContent.RqPath, rqPath...)
+	rqPath := getPathParameter(swagger, resource.Endpoint, resource.RequestVerb, &issues)
+	resource.ResourceContent.RqPath = append(resource.ResourceContent.RqPath, rqPath...)
 
-		rqHeader := getParameter(swagger, resource.Endpoint, resource.RequestVerb, "header")
-		resource.ResourceContent.RqHeader = append(resource.ResourceContent.RqHeader, rqHeader...)
+	rqHeader := getParameter(swagger, resource.Endpoint, resource.RequestVerb, "header", &issues)
+	resource.ResourceContent.RqHeader = append(resource.ResourceContent.RqHeader, rqHeader...)
 
-		rqQuery := getParameter(swagger, resource.Endpoint, resource.RequestVerb, "query")
-		resource.ResourceContent.RqQuery = append(resource.ResourceContent.RqQuery, rqQuery...)
+	rqQuery := getParameter(swagger, resource.Endpoint, resource.RequestVerb, "query", &issues)
+	resource.ResourceContent.RqQuery = append(resource.ResourceContent.RqQuery, rqQuery...)
 
-		rqBody := getRqBody(swagger, resource.Endpoint, resource.RequestVerb)
-		resource.ResourceContent.RqBody = rqBody
+	rqBody := getRqBody(swagger, resource.Endpoint, resource.RequestVerb, &issues)
+	resource.ResourceContent.RqBody = rqBody
 
-		rqBodyExample := getRqBodyExample(swagger, resource.Endpoint, resource.RequestVerb)
-		resource.ResourceContent.RqBodyExample = rqBodyExample
+	rqBodyExample := getRqBodyExample(swagger, resource.Endpoint, resource.RequestVerb, &issues)
+	resource.ResourceContent.RqBodyExample = rqBodyExample
 
-		rsHeader := getRsHeader(swagger, resource.Endpoint, resource.RequestVerb)
-		resource.ResourceContent.RsHeader = rsHeader
+	rsHeader := getRsHeader(swagger, resource.Endpoint, resource.RequestVerb, &issues)
+	resource.ResourceContent.RsHeader = rsHeader
 
-		rsBody := getRsBody(swagger, resource.Endpoint, resource.RequestVerb)
-		resource.ResourceContent.RsBody = rsBody
+	rsBody := getRsBody(swagger, resource.Endpoint, resource.RequestVerb, &issues)
+	resource.ResourceContent.RsBody = rsBody
 
-		rsBodyExample := getRsBodyExample(swagger, resource.Endpoint, resource.RequestVerb)
-		resource.ResourceContent.RsBodyExample = rsBodyExample
-	}
+	rsBodyExample := getRsBodyExample(swagger, resource.Endpoint, resource.RequestVerb, &issues)
+	resource.ResourceContent.RsBodyExample = rsBodyExample
 
-	return resources
+	return issues
 }
 
 func getInfo(swagger *openapi3.Swagger) Info {
@@ -462,10 +651,23 @@ func getInfo(swagger *openapi3.Swagger) Info {
 	return info
 }
 
-func Transform(swagger *openapi3.Swagger) Design {
+// TransformWithIssues is the validation-aware counterpart to Transform: it
+// never panics on a partially-specified document, and instead reports one
+// ImportIssue for each operation or field it had to skip while still
+// returning a best-effort Design built from everything it could read.
+func TransformWithIssues(swagger *openapi3.Swagger) (Design, []error) {
 	var design Design
-	design.Info = getInfo(swagger)
-	design.Resources = append(design.Resources, getResourceContent(swagger, getResource(swagger))...)
+	if swagger.Info != nil {
+		design.Info = getInfo(swagger)
+	}
+
+	resources, issues := getResourceContent(swagger, getResource(swagger))
+	design.Resources = append(design.Resources, resources...)
 
+	return design, issues
+}
+
+func Transform(swagger *openapi3.Swagger) Design {
+	design, _ := TransformWithIssues(swagger)
 	return design
 }
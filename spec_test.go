@@ -0,0 +1,260 @@
+package spec
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func loadFixture(t *testing.T) *openapi3.Swagger {
+	t.Helper()
+
+	data, err := ioutil.ReadFile("testdata/fixture.json")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData(data)
+	if err != nil {
+		t.Fatalf("load fixture: %v", err)
+	}
+
+	return swagger
+}
+
+func findParameter(parameters []*Parameter, name string) *Parameter {
+	for _, parameter := range parameters {
+		if parameter.Name == name {
+			return parameter
+		}
+	}
+
+	return nil
+}
+
+func TestTransformFromBytesDetectsFormat(t *testing.T) {
+	swagger2, err := ioutil.ReadFile("testdata/fixture_swagger2.json")
+	if err != nil {
+		t.Fatalf("read swagger2 fixture: %v", err)
+	}
+
+	design, err := TransformFromBytes(swagger2)
+	if err != nil {
+		t.Fatalf("transform swagger2: %v", err)
+	}
+	if len(design.Resources) != 1 || design.Resources[0].Endpoint != "/ping" {
+		t.Fatalf("expected one /ping resource from swagger2 fixture, got %+v", design.Resources)
+	}
+
+	openapi31, err := ioutil.ReadFile("testdata/fixture_openapi31.json")
+	if err != nil {
+		t.Fatalf("read openapi 3.1 fixture: %v", err)
+	}
+
+	design, err = TransformFromBytes(openapi31)
+	if err != nil {
+		t.Fatalf("transform openapi 3.1: %v", err)
+	}
+
+	body := design.Resources[0].ResourceContent.RsBody["200"]["application/json"]
+	note := findParameter(body, "note")
+	if note == nil {
+		t.Fatalf("expected a \"note\" property in the 3.1 response body, got %+v", body)
+	}
+	if note.DataType != "string" {
+		t.Fatalf("expected type: [\"string\", \"null\"] to normalize to \"string\", got %q", note.DataType)
+	}
+}
+
+func TestTransformKeysResponsesByStatusCode(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/fixture_multistatus.json")
+	if err != nil {
+		t.Fatalf("read multistatus fixture: %v", err)
+	}
+
+	design, err := TransformFromBytes(data)
+	if err != nil {
+		t.Fatalf("transform: %v", err)
+	}
+
+	content := design.Resources[0].ResourceContent
+
+	for code, field := range map[string]string{"200": "id", "404": "message", "default": "message"} {
+		body := content.RsBody[code]["application/json"]
+		if findParameter(body, field) == nil {
+			t.Fatalf("expected status %s body to have a %q property, got %+v", code, field, body)
+		}
+	}
+
+	header := findParameter(content.RsHeader["200"], "X-Request-Id")
+	if header == nil {
+		t.Fatalf("expected status 200 to have an X-Request-Id header, got %+v", content.RsHeader["200"])
+	}
+	if _, ok := content.RsHeader["404"]; ok {
+		t.Fatalf("expected status 404 to have no headers, got %+v", content.RsHeader["404"])
+	}
+}
+
+func findParameterByParent(parameters []*Parameter, parent string, name string) *Parameter {
+	for _, parameter := range parameters {
+		if parameter.Parent == parent && parameter.Name == name {
+			return parameter
+		}
+	}
+
+	return nil
+}
+
+func findResource(resources []*Resource, endpoint string) *Resource {
+	for _, resource := range resources {
+		if resource.Endpoint == endpoint {
+			return resource
+		}
+	}
+
+	return nil
+}
+
+func TestTransformResolvesCompositionAndRecursion(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/fixture_composition.json")
+	if err != nil {
+		t.Fatalf("read composition fixture: %v", err)
+	}
+
+	design, err := TransformFromBytes(data)
+	if err != nil {
+		t.Fatalf("transform: %v", err)
+	}
+
+	shapesResource := findResource(design.Resources, "/shapes")
+	treeResource := findResource(design.Resources, "/tree")
+	if shapesResource == nil || treeResource == nil {
+		t.Fatalf("expected /shapes and /tree resources, got %+v", design.Resources)
+	}
+
+	shapes := shapesResource.ResourceContent.RqBody["application/json"]
+
+	circle := findParameterByParent(shapes, "Shape[oneOf:circle]", "Circle")
+	square := findParameterByParent(shapes, "Shape[oneOf:square]", "Square")
+	if circle == nil || square == nil {
+		t.Fatalf("expected oneOf branches keyed by discriminator mapping, got %+v", shapes)
+	}
+
+	for _, branch := range []struct {
+		parent string
+		field  string
+	}{{"Circle", "kind"}, {"Circle", "radius"}, {"Square", "kind"}, {"Square", "side"}} {
+		property := findParameterByParent(shapes, branch.parent, branch.field)
+		if property == nil {
+			t.Fatalf("expected allOf to merge %q onto %q, got %+v", branch.field, branch.parent, shapes)
+		}
+		if !property.Required {
+			t.Fatalf("expected merged property %q on %q to stay required, got %+v", branch.field, branch.parent, property)
+		}
+	}
+
+	tree := treeResource.ResourceContent.RsBody["200"]["application/json"]
+
+	children := findParameterByParent(tree, "TreeNode", "children")
+	if children == nil || children.DataType != "array[object]" {
+		t.Fatalf("expected a children array[object] property on TreeNode, got %+v", tree)
+	}
+
+	cycle := findParameterByParent(tree, "children", "TreeNode")
+	if cycle == nil || cycle.DataType != "recursive[TreeNode]" {
+		t.Fatalf("expected the recursive TreeNode reference to be marked recursive[TreeNode], got %+v", tree)
+	}
+}
+
+func TestToOpenAPIRoundTripsCompositionAndRecursion(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/fixture_composition.json")
+	if err != nil {
+		t.Fatalf("read composition fixture: %v", err)
+	}
+
+	design, err := TransformFromBytes(data)
+	if err != nil {
+		t.Fatalf("transform: %v", err)
+	}
+
+	swagger, err := design.ToOpenAPI()
+	if err != nil {
+		t.Fatalf("ToOpenAPI: %v", err)
+	}
+
+	// object/array + the recursive[...] sentinel: /tree's response re-nests
+	// into an object whose "children" array items point back at TreeNode.
+	treeItem := swagger.Paths["/tree"]
+	if treeItem == nil || treeItem.Get == nil {
+		t.Fatalf("expected a GET /tree operation, got %+v", swagger.Paths)
+	}
+
+	treeSchema := treeItem.Get.Responses["200"].Value.Content["application/json"].Schema.Value
+	if treeSchema.Type != "object" {
+		t.Fatalf("expected TreeNode to round-trip as an object, got %+v", treeSchema)
+	}
+	if _, ok := treeSchema.Properties["label"]; !ok {
+		t.Fatalf("expected TreeNode to keep its \"label\" property, got %+v", treeSchema.Properties)
+	}
+
+	children, ok := treeSchema.Properties["children"]
+	if !ok || children.Value.Type != "array" {
+		t.Fatalf("expected TreeNode to keep its \"children\" array property, got %+v", treeSchema.Properties)
+	}
+	if children.Value.Items == nil || children.Value.Items.Ref != "#/components/schemas/TreeNode" {
+		t.Fatalf("expected the recursive children item to ref back to TreeNode, got %+v", children.Value.Items)
+	}
+
+	// oneOf: /shapes' requestBody re-nests into two variants, each carrying
+	// its merged allOf properties.
+	shapesItem := swagger.Paths["/shapes"]
+	if shapesItem == nil || shapesItem.Post == nil {
+		t.Fatalf("expected a POST /shapes operation, got %+v", swagger.Paths)
+	}
+
+	shapeSchema := shapesItem.Post.RequestBody.Value.Content["application/json"].Schema.Value
+	if len(shapeSchema.OneOf) != 2 {
+		t.Fatalf("expected 2 oneOf variants for Shape, got %+v", shapeSchema.OneOf)
+	}
+	for _, variant := range shapeSchema.OneOf {
+		if variant.Value == nil || variant.Value.Properties["kind"] == nil {
+			t.Fatalf("expected oneOf variant to carry its merged allOf properties, got %+v", variant.Value)
+		}
+	}
+
+	// anyOf: /label's requestBody re-nests into its two plain variants.
+	labelItem := swagger.Paths["/label"]
+	if labelItem == nil || labelItem.Post == nil {
+		t.Fatalf("expected a POST /label operation, got %+v", swagger.Paths)
+	}
+
+	labelSchema := labelItem.Post.RequestBody.Value.Content["application/json"].Schema.Value
+	if len(labelSchema.AnyOf) != 2 {
+		t.Fatalf("expected 2 anyOf variants for Label, got %+v", labelSchema.AnyOf)
+	}
+
+	if _, err := design.ToOpenAPIJSON(); err != nil {
+		t.Fatalf("ToOpenAPIJSON: %v", err)
+	}
+	if _, err := design.ToOpenAPIYAML(); err != nil {
+		t.Fatalf("ToOpenAPIYAML: %v", err)
+	}
+}
+
+func TestTransformIsDeterministic(t *testing.T) {
+	first, err := json.Marshal(Transform(loadFixture(t)))
+	if err != nil {
+		t.Fatalf("marshal first transform: %v", err)
+	}
+
+	second, err := json.Marshal(Transform(loadFixture(t)))
+	if err != nil {
+		t.Fatalf("marshal second transform: %v", err)
+	}
+
+	if string(first) != string(second) {
+		t.Fatalf("Transform is not deterministic across runs:\nfirst:  %s\nsecond: %s", first, second)
+	}
+}
@@ -0,0 +1,17 @@
+package spec
+
+import "fmt"
+
+// ImportIssue records one operation or field that TransformWithIssues had
+// to skip or degrade while importing a spec, so callers can surface a full
+// report instead of losing the reason behind a missing resource.
+type ImportIssue struct {
+	Endpoint string `json:"endpoint"`
+	Method   string `json:"method"`
+	Field    string `json:"field"`
+	Message  string `json:"message"`
+}
+
+func (issue ImportIssue) Error() string {
+	return fmt.Sprintf("%s %s: %s: %s", issue.Method, issue.Endpoint, issue.Field, issue.Message)
+}